@@ -0,0 +1,187 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MemberRef identifies a single Team member, either directly by Upbound
+// user ID or indirectly by the email address associated with their
+// Upbound account.
+type MemberRef struct {
+	// UserID of the member.
+	// +optional
+	UserID *string `json:"userId,omitempty"`
+
+	// Email of the member. Used to resolve a UserID when one isn't supplied
+	// directly.
+	// +optional
+	Email *string `json:"email,omitempty"`
+}
+
+// TeamParameters are the configurable fields of a Team.
+type TeamParameters struct {
+	// OrganizationName this Team belongs to.
+	OrganizationName string `json:"organizationName"`
+
+	// Name of the Team.
+	Name string `json:"name"`
+
+	// MemberRefs are the members that should belong to this Team. On each
+	// reconcile the controller diffs this list against the Team's current
+	// membership and adds or removes members to match it exactly.
+	// +optional
+	MemberRefs []MemberRef `json:"memberRefs,omitempty"`
+}
+
+// TeamObservation are the observable fields of a Team.
+type TeamObservation struct {
+	// ID of the Team as assigned by Upbound.
+	ID string `json:"id,omitempty"`
+
+	// MemberIDs currently belonging to this Team, as last observed.
+	MemberIDs []string `json:"memberIds,omitempty"`
+
+	// ManagementPolicies in effect for this Team, as last observed. Mirrors
+	// spec.managementPolicies so the policy enforced for this resource is
+	// visible without reading its spec.
+	ManagementPolicies xpv1.ManagementPolicies `json:"managementPolicies,omitempty"`
+}
+
+// A TeamSpec defines the desired state of a Team.
+type TeamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamParameters `json:"forProvider"`
+}
+
+// A TeamStatus represents the observed state of a Team.
+type TeamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,upbound}
+
+// A Team is a managed resource that represents an Upbound organization
+// Team, including its membership.
+type Team struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamSpec   `json:"spec"`
+	Status TeamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamList contains a list of Team.
+type TeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Team `json:"items"`
+}
+
+// TeamMembershipParameters are the configurable fields of a TeamMembership.
+// TeamMembership grants a single member access to a Team, for users who
+// prefer one-member-per-CR over Team.spec.forProvider.memberRefs.
+type TeamMembershipParameters struct {
+	// OrganizationName the Team belongs to.
+	OrganizationName string `json:"organizationName"`
+
+	// TeamName the member should belong to.
+	TeamName string `json:"teamName"`
+
+	// MemberRef identifies the member to add to the Team.
+	MemberRef MemberRef `json:"memberRef"`
+}
+
+// TeamMembershipObservation are the observable fields of a TeamMembership.
+type TeamMembershipObservation struct {
+	// UserID of the member, as resolved by the provider.
+	UserID string `json:"userId,omitempty"`
+
+	// ManagementPolicies in effect for this TeamMembership, as last
+	// observed. Mirrors spec.managementPolicies so the policy enforced for
+	// this resource is visible without reading its spec.
+	ManagementPolicies xpv1.ManagementPolicies `json:"managementPolicies,omitempty"`
+}
+
+// A TeamMembershipSpec defines the desired state of a TeamMembership.
+type TeamMembershipSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TeamMembershipParameters `json:"forProvider"`
+}
+
+// A TeamMembershipStatus represents the observed state of a TeamMembership.
+type TeamMembershipStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TeamMembershipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,upbound}
+
+// A TeamMembership is a managed resource that grants a single member
+// access to an Upbound Team.
+type TeamMembership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamMembershipSpec   `json:"spec"`
+	Status TeamMembershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TeamMembershipList contains a list of TeamMembership.
+type TeamMembershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeamMembership `json:"items"`
+}
+
+// Team type metadata.
+var (
+	TeamKind             = "Team"
+	TeamGroupKind        = TeamKind + "." + Group
+	TeamKindAPIVersion   = TeamKind + "." + SchemeGroupVersion.String()
+	TeamGroupVersionKind = SchemeGroupVersion.WithKind(TeamKind)
+)
+
+// TeamMembership type metadata.
+var (
+	TeamMembershipKind             = "TeamMembership"
+	TeamMembershipGroupKind        = TeamMembershipKind + "." + Group
+	TeamMembershipKindAPIVersion   = TeamMembershipKind + "." + SchemeGroupVersion.String()
+	TeamMembershipGroupVersionKind = SchemeGroupVersion.WithKind(TeamMembershipKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Team{}, &TeamList{})
+	SchemeBuilder.Register(&TeamMembership{}, &TeamMembershipList{})
+}
@@ -18,6 +18,8 @@ package main
 
 import (
 	"context"
+	"net/http"
+	"net/http/pprof"
 	"os"
 	"path/filepath"
 	"time"
@@ -28,6 +30,7 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -39,7 +42,7 @@ import (
 	"github.com/upbound/provider-upbound/apis"
 	"github.com/upbound/provider-upbound/apis/v1alpha1"
 	upbound "github.com/upbound/provider-upbound/internal/controller"
-	"github.com/upbound/provider-upbound/internal/controller/features"
+	"github.com/upbound/provider-upbound/internal/features"
 )
 
 func main() {
@@ -54,6 +57,10 @@ func main() {
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
+		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("true").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+
+		metricsBindAddress = app.Flag("metrics-bind-address", "Address for the Prometheus metrics endpoint.").Default(":8080").String()
+		pprofBindAddress   = app.Flag("pprof-bind-address", "Address for the pprof debug endpoint. Leave unset to disable.").Default("").String()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -69,8 +76,22 @@ func main() {
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
+	if *pprofBindAddress != "" {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+		go func() {
+			log.Info("Starting pprof server", "address", *pprofBindAddress)
+			log.Info("pprof server exited", "error", http.ListenAndServe(*pprofBindAddress, mux)) //nolint:gosec // debug endpoint, operator controls the bind address
+		}()
+	}
+
 	mgr, err := ctrl.NewManager(ratelimiter.LimitRESTConfig(cfg, *maxReconcileRate), ctrl.Options{
 		SyncPeriod: syncInterval,
+		Metrics:    ctrlmetricsserver.Options{BindAddress: *metricsBindAddress},
 
 		// controller-runtime uses both ConfigMaps and Leases for leader
 		// election by default. Leases expire after 15 seconds, with a
@@ -96,6 +117,11 @@ func main() {
 		Features:                &feature.Flags{},
 	}
 
+	if *enableManagementPolicies {
+		o.Features.Enable(features.EnableBetaManagementPolicies)
+		log.Info("Beta feature enabled", "flag", features.EnableBetaManagementPolicies)
+	}
+
 	if *enableExternalSecretStores {
 		o.Features.Enable(features.EnableAlphaExternalSecretStores)
 		log.Info("Alpha feature enabled", "flag", features.EnableAlphaExternalSecretStores)
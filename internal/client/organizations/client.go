@@ -0,0 +1,46 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+
+	up "github.com/upbound/up-sdk-go"
+	upsdkorgs "github.com/upbound/up-sdk-go/service/organizations"
+)
+
+// Client is used to interact with the Upbound API to look up Organizations.
+type Client struct {
+	organizations *upsdkorgs.Client
+}
+
+// NewClient creates a new Organizations Client.
+func NewClient(cfg *up.Config) *Client {
+	return &Client{organizations: upsdkorgs.NewClient(cfg)}
+}
+
+// GetByName resolves an organization's account name to its numeric ID. The
+// Upbound API identifies organizations by ID everywhere except the account
+// name used in URLs and CRD specs, so every controller that calls an
+// org-scoped endpoint needs this lookup.
+func (c *Client) GetByName(ctx context.Context, name string) (*GetResponse, error) {
+	rsp, err := c.organizations.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &GetResponse{ID: rsp.ID, Name: rsp.Name}, nil
+}
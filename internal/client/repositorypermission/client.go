@@ -0,0 +1,64 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositorypermission
+
+import (
+	"context"
+
+	up "github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/repositories"
+)
+
+// Client is used to interact with the Upbound API to manage repository
+// permission grants.
+type Client struct {
+	repositories *repositories.Client
+}
+
+// NewClient creates a new repository permission Client.
+func NewClient(cfg *up.Config) *Client {
+	return &Client{repositories: repositories.NewClient(cfg)}
+}
+
+// Get looks up the permission currently granted to the given team on the
+// given repository. It returns an error satisfying uperrors.IsNotFound if no
+// such grant exists.
+func (c *Client) Get(ctx context.Context, p *GetParameters) (*GetResponse, error) {
+	perm, err := c.repositories.GetTeamPermission(ctx, p.Organization, p.Repository, p.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	return &GetResponse{Permission: perm.Permission}, nil
+}
+
+// Create grants the given team the given permission on the given repository.
+func (c *Client) Create(ctx context.Context, p *CreateParameters) error {
+	return c.repositories.SetTeamPermission(ctx, p.Organization, p.Repository, p.TeamID, p.Permission)
+}
+
+// Update changes the permission level currently granted to a team on a
+// repository. The Upbound API has no dedicated PATCH for this relationship,
+// so a create-or-replace call is sufficient: granting a permission to a team
+// that already has one simply overwrites it.
+func (c *Client) Update(ctx context.Context, p *UpdateParameters) error {
+	return c.repositories.SetTeamPermission(ctx, p.Organization, p.Repository, p.TeamID, p.Permission)
+}
+
+// Delete revokes any permission the given team has on the given repository.
+func (c *Client) Delete(ctx context.Context, p *GetParameters) error {
+	return c.repositories.RemoveTeamPermission(ctx, p.Organization, p.Repository, p.TeamID)
+}
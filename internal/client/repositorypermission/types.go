@@ -0,0 +1,49 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositorypermission
+
+// GetParameters are the parameters used to look up a repository permission
+// grant for a team.
+type GetParameters struct {
+	Repository   string
+	Organization string
+	TeamID       string
+}
+
+// GetResponse is the current state of a repository permission grant as
+// reported by the Upbound API.
+type GetResponse struct {
+	Permission string `json:"permission"`
+}
+
+// CreateParameters are the parameters used to create (or replace) a
+// repository permission grant for a team.
+type CreateParameters struct {
+	Repository   string
+	Organization string
+	TeamID       string
+	Permission   string
+}
+
+// UpdateParameters are the parameters used to update an existing repository
+// permission grant for a team.
+type UpdateParameters struct {
+	Repository   string
+	Organization string
+	TeamID       string
+	Permission   string
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teams
+
+import (
+	"context"
+
+	up "github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/teams"
+)
+
+// Client is used to interact with the Upbound API to manage Teams and their
+// membership.
+type Client struct {
+	teams *teams.Client
+}
+
+// NewClient creates a new Team Client.
+func NewClient(cfg *up.Config) *Client {
+	return &Client{teams: teams.NewClient(cfg)}
+}
+
+// Get looks up a Team by ID.
+func (c *Client) Get(ctx context.Context, teamID string) (*GetResponse, error) {
+	rsp, err := c.teams.Get(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return &GetResponse{ID: rsp.ID, Name: rsp.Name}, nil
+}
+
+// GetByName looks up a Team by its human-readable name within an
+// organization.
+func (c *Client) GetByName(ctx context.Context, p *GetByNameParameters) (*GetResponse, error) {
+	rsp, err := c.teams.GetByName(ctx, p.OrganizationID, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &GetResponse{ID: rsp.ID, Name: rsp.Name}, nil
+}
+
+// Update renames an existing Team. Every other Team attribute is
+// immutable - organization and membership are handled separately.
+func (c *Client) Update(ctx context.Context, teamID string, p *UpdateParameters) error {
+	return c.teams.Update(ctx, teamID, &teams.TeamUpdateParameters{Name: p.Name})
+}
+
+// Create creates a new Team.
+func (c *Client) Create(ctx context.Context, p *CreateParameters) (*CreateResponse, error) {
+	rsp, err := c.teams.Create(ctx, &teams.TeamCreateParameters{
+		Name:           p.Name,
+		OrganizationID: p.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CreateResponse{ID: rsp.ID}, nil
+}
+
+// Delete deletes a Team.
+func (c *Client) Delete(ctx context.Context, teamID string) error {
+	return c.teams.Delete(ctx, teamID)
+}
+
+// ListMembers returns the members currently belonging to a Team.
+func (c *Client) ListMembers(ctx context.Context, p *ListMembersParameters) (*ListMembersResponse, error) {
+	rsp, err := c.teams.ListMembers(ctx, p.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]Member, 0, len(rsp.Members))
+	for _, m := range rsp.Members {
+		members = append(members, Member{UserID: m.UserID})
+	}
+	return &ListMembersResponse{Members: members}, nil
+}
+
+// AddMember adds a member to a Team.
+func (c *Client) AddMember(ctx context.Context, p *MemberParameters) error {
+	return c.teams.AddMember(ctx, p.TeamID, p.UserID)
+}
+
+// RemoveMember removes a member from a Team.
+func (c *Client) RemoveMember(ctx context.Context, p *MemberParameters) error {
+	return c.teams.RemoveMember(ctx, p.TeamID, p.UserID)
+}
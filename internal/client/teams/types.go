@@ -16,10 +16,11 @@ limitations under the License.
 
 package teams
 
-import "github.com/upbound/up-sdk-go/service/common"
-
+// GetResponse is the current state of a Team as reported by the Upbound
+// API.
 type GetResponse struct {
-	common.DataSet `json:"data"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 type CreateParameters struct {
@@ -30,3 +31,38 @@ type CreateParameters struct {
 type CreateResponse struct {
 	ID string `json:"id"`
 }
+
+// UpdateParameters rename an existing Team.
+type UpdateParameters struct {
+	Name string `json:"name"`
+}
+
+// GetByNameParameters identify a Team by its human-readable name rather
+// than its ID, as used e.g. by TeamMembership's spec.forProvider.teamName.
+type GetByNameParameters struct {
+	OrganizationID uint
+	Name           string
+}
+
+// MemberParameters identify a single Team member for add/remove operations.
+type MemberParameters struct {
+	OrganizationName string
+	TeamID           string
+	UserID           string
+}
+
+// ListMembersParameters identify the Team whose membership should be listed.
+type ListMembersParameters struct {
+	OrganizationName string
+	TeamID           string
+}
+
+// Member is a single Team member as reported by the Upbound API.
+type Member struct {
+	UserID string `json:"userId"`
+}
+
+// ListMembersResponse is the current membership of a Team.
+type ListMembersResponse struct {
+	Members []Member `json:"data"`
+}
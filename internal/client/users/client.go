@@ -0,0 +1,44 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package users
+
+import (
+	"context"
+
+	up "github.com/upbound/up-sdk-go"
+	upsdkusers "github.com/upbound/up-sdk-go/service/users"
+)
+
+// Client is used to interact with the Upbound API to look up Users.
+type Client struct {
+	users *upsdkusers.Client
+}
+
+// NewClient creates a new Users Client.
+func NewClient(cfg *up.Config) *Client {
+	return &Client{users: upsdkusers.NewClient(cfg)}
+}
+
+// GetByEmail resolves the email address associated with an Upbound account
+// to that account's user ID.
+func (c *Client) GetByEmail(ctx context.Context, email string) (*GetResponse, error) {
+	rsp, err := c.users.GetByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	return &GetResponse{UserID: rsp.ID, Email: rsp.Email}, nil
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -36,6 +37,7 @@ import (
 	upclient "github.com/upbound/provider-upbound/internal/client"
 	"github.com/upbound/provider-upbound/internal/client/repositorypermission"
 	"github.com/upbound/provider-upbound/internal/features"
+	"github.com/upbound/provider-upbound/internal/metrics"
 )
 
 const (
@@ -43,12 +45,20 @@ const (
 	errTrackPCUsage  = "cannot track ProviderConfig usage"
 
 	errNewClient = "cannot create new client"
+
+	errGetPermission    = "cannot get repository permission"
+	errUpdatePermission = "cannot update repository permission"
 )
 
 // Setup adds a controller that reconciles Permission managed resources.
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1alpha1.PermissionGroupKind)
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnecter(&connector{
 			kube:  mgr.GetClient(),
@@ -62,7 +72,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 	}
 
-	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
 		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
 	}
 
@@ -110,12 +120,21 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}, nil
 }
 
+// PermissionClient is satisfied by repositorypermission.Client. It exists so
+// that tests can supply a fake implementation.
+type PermissionClient interface {
+	Get(ctx context.Context, p *repositorypermission.GetParameters) (*repositorypermission.GetResponse, error)
+	Create(ctx context.Context, p *repositorypermission.CreateParameters) error
+	Update(ctx context.Context, p *repositorypermission.UpdateParameters) error
+	Delete(ctx context.Context, p *repositorypermission.GetParameters) error
+}
+
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an Upbound SDK client.
-	repositorypermission *repositorypermission.Client
+	repositorypermission PermissionClient
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -124,19 +143,30 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotPermission)
 	}
 
-	err := c.repositorypermission.Get(ctx, &repositorypermission.GetParameters{
-		Repository:   pointer.StringDeref(cr.Spec.ForProvider.Repository, ""),
-		Organization: cr.Spec.ForProvider.OrganizationName,
-		TeamID:       pointer.StringDeref(cr.Spec.ForProvider.TeamID, ""),
+	var rsp *repositorypermission.GetResponse
+	err := metrics.ObserveCall("Permission", cr, "Get", func() error {
+		var getErr error
+		rsp, getErr = c.repositorypermission.Get(ctx, &repositorypermission.GetParameters{
+			Repository:   pointer.StringDeref(cr.Spec.ForProvider.Repository, ""),
+			Organization: cr.Spec.ForProvider.OrganizationName,
+			TeamID:       pointer.StringDeref(cr.Spec.ForProvider.TeamID, ""),
+		})
+		return getErr
 	})
-
+	if uperrors.IsNotFound(err) {
+		// Either the permission grant was removed from the repository, or
+		// the team itself no longer exists. Either way there's nothing for
+		// us to observe.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
 	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "failed to get team")
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPermission)
 	}
+
 	cr.Status.SetConditions(v1.Available())
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceUpToDate: rsp.Permission == cr.Spec.ForProvider.Permission,
 	}, nil
 }
 
@@ -146,11 +176,13 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotPermission)
 	}
 
-	err := c.repositorypermission.Create(ctx, &repositorypermission.CreateParameters{
-		Repository:   pointer.StringDeref(cr.Spec.ForProvider.Repository, ""),
-		Organization: cr.Spec.ForProvider.OrganizationName,
-		TeamID:       pointer.StringDeref(cr.Spec.ForProvider.TeamID, ""),
-		Permission:   cr.Spec.ForProvider.Permission,
+	err := metrics.ObserveCall("Permission", cr, "Create", func() error {
+		return c.repositorypermission.Create(ctx, &repositorypermission.CreateParameters{
+			Repository:   pointer.StringDeref(cr.Spec.ForProvider.Repository, ""),
+			Organization: cr.Spec.ForProvider.OrganizationName,
+			TeamID:       pointer.StringDeref(cr.Spec.ForProvider.TeamID, ""),
+			Permission:   cr.Spec.ForProvider.Permission,
+		})
 	})
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "failed to create repository permission")
@@ -158,11 +190,31 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	meta.SetExternalName(cr, pointer.StringDeref(cr.Spec.ForProvider.Repository, ""))
 
-	return managed.ExternalCreation{}, nil
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			"repository": []byte(pointer.StringDeref(cr.Spec.ForProvider.Repository, "")),
+			"permission": []byte(cr.Spec.ForProvider.Permission),
+		},
+	}, nil
 }
 
-func (c *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
-	return managed.ExternalUpdate{}, nil
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Permission)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPermission)
+	}
+
+	// The Upbound API has no PATCH for a repository permission grant, so we
+	// create-or-replace it with the desired permission level.
+	err := metrics.ObserveCall("Permission", cr, "Update", func() error {
+		return c.repositorypermission.Update(ctx, &repositorypermission.UpdateParameters{
+			Repository:   pointer.StringDeref(cr.Spec.ForProvider.Repository, ""),
+			Organization: cr.Spec.ForProvider.OrganizationName,
+			TeamID:       pointer.StringDeref(cr.Spec.ForProvider.TeamID, ""),
+			Permission:   cr.Spec.ForProvider.Permission,
+		})
+	})
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePermission)
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -171,11 +223,12 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotPermission)
 	}
 
-	err := c.repositorypermission.Delete(ctx, &repositorypermission.GetParameters{
-		Repository:   pointer.StringDeref(cr.Spec.ForProvider.Repository, ""),
-		Organization: cr.Spec.ForProvider.OrganizationName,
-		TeamID:       pointer.StringDeref(cr.Spec.ForProvider.TeamID, ""),
+	err := metrics.ObserveCall("Permission", cr, "Delete", func() error {
+		return c.repositorypermission.Delete(ctx, &repositorypermission.GetParameters{
+			Repository:   pointer.StringDeref(cr.Spec.ForProvider.Repository, ""),
+			Organization: cr.Spec.ForProvider.OrganizationName,
+			TeamID:       pointer.StringDeref(cr.Spec.ForProvider.TeamID, ""),
+		})
 	})
 	return errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot delete repositroy permission")
-
 }
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositorypermission
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/upbound/provider-upbound/apis/repository/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/repositorypermission"
+)
+
+var errBoom = errors.New("boom")
+
+type fakePermissionClient struct {
+	MockGet    func(ctx context.Context, p *repositorypermission.GetParameters) (*repositorypermission.GetResponse, error)
+	MockCreate func(ctx context.Context, p *repositorypermission.CreateParameters) error
+	MockUpdate func(ctx context.Context, p *repositorypermission.UpdateParameters) error
+	MockDelete func(ctx context.Context, p *repositorypermission.GetParameters) error
+}
+
+func (f *fakePermissionClient) Get(ctx context.Context, p *repositorypermission.GetParameters) (*repositorypermission.GetResponse, error) {
+	return f.MockGet(ctx, p)
+}
+
+func (f *fakePermissionClient) Create(ctx context.Context, p *repositorypermission.CreateParameters) error {
+	return f.MockCreate(ctx, p)
+}
+
+func (f *fakePermissionClient) Update(ctx context.Context, p *repositorypermission.UpdateParameters) error {
+	return f.MockUpdate(ctx, p)
+}
+
+func (f *fakePermissionClient) Delete(ctx context.Context, p *repositorypermission.GetParameters) error {
+	return f.MockDelete(ctx, p)
+}
+
+func permission(rev string) *v1alpha1.Permission {
+	cr := &v1alpha1.Permission{}
+	cr.Spec.ForProvider.Repository = pointer.String("my-repo")
+	cr.Spec.ForProvider.OrganizationName = "my-org"
+	cr.Spec.ForProvider.TeamID = pointer.String("my-team")
+	cr.Spec.ForProvider.Permission = rev
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		client PermissionClient
+		mg     resource.Managed
+	}
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoChange": {
+			args: args{
+				client: &fakePermissionClient{
+					MockGet: func(_ context.Context, _ *repositorypermission.GetParameters) (*repositorypermission.GetResponse, error) {
+						return &repositorypermission.GetResponse{Permission: "admin"}, nil
+					},
+				},
+				mg: permission("admin"),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ChangedPermission": {
+			args: args{
+				client: &fakePermissionClient{
+					MockGet: func(_ context.Context, _ *repositorypermission.GetParameters) (*repositorypermission.GetResponse, error) {
+						return &repositorypermission.GetResponse{Permission: "read"}, nil
+					},
+				},
+				mg: permission("admin"),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		// A 404 from Get covers two distinct remote states - the permission
+		// grant was removed from the repository, or the team it was granted
+		// to no longer exists - but the API doesn't distinguish them and
+		// neither does Observe, so one case covers both.
+		"NotFoundOnRemote": {
+			args: args{
+				client: &fakePermissionClient{
+					MockGet: func(_ context.Context, _ *repositorypermission.GetParameters) (*repositorypermission.GetResponse, error) {
+						return nil, &uperrors.Error{Status: 404}
+					},
+				},
+				mg: permission("admin"),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fakePermissionClient{
+					MockGet: func(_ context.Context, _ *repositorypermission.GetParameters) (*repositorypermission.GetResponse, error) {
+						return nil, errBoom
+					},
+				},
+				mg: permission("admin"),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetPermission),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{repositorypermission: tc.args.client}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s", name, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got, cmpopts.IgnoreFields(managed.ExternalObservation{}, "ConnectionDetails", "Diff")); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", name, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		client PermissionClient
+		mg     resource.Managed
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Success": {
+			args: args{
+				client: &fakePermissionClient{
+					MockUpdate: func(_ context.Context, p *repositorypermission.UpdateParameters) error {
+						if p.Permission != "read" {
+							t.Fatalf("unexpected permission passed to Update: %s", p.Permission)
+						}
+						return nil
+					},
+				},
+				mg: permission("read"),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				client: &fakePermissionClient{
+					MockUpdate: func(_ context.Context, _ *repositorypermission.UpdateParameters) error {
+						return errBoom
+					},
+				},
+				mg: permission("read"),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdatePermission),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{repositorypermission: tc.args.client}
+			_, err := e.Update(context.Background(), tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s", name, diff)
+			}
+		})
+	}
+}
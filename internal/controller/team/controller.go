@@ -0,0 +1,371 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package team
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/upbound/provider-upbound/apis/team/v1alpha1"
+	apisv1alpha1 "github.com/upbound/provider-upbound/apis/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/organizations"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+	"github.com/upbound/provider-upbound/internal/client/users"
+	"github.com/upbound/provider-upbound/internal/features"
+	"github.com/upbound/provider-upbound/internal/metrics"
+	"github.com/upbound/provider-upbound/internal/resolve"
+)
+
+const (
+	errNotTeam      = "managed resource is not a Team custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errNewClient    = "cannot create new client"
+
+	errGetOrg          = "cannot resolve organizationName to an organization"
+	errGetTeam         = "cannot get team"
+	errCreateTeam      = "cannot create team"
+	errUpdateTeam      = "cannot rename team"
+	errDeleteTeam      = "cannot delete team"
+	errListMembers     = "cannot list team members"
+	errResolveMembers  = "cannot resolve team memberRefs to user ids"
+	errReconcileMember = "cannot reconcile team member"
+)
+
+// Setup adds a controller that reconciles Team managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TeamGroupKind)
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		}),
+		managed.WithConnectionPublishers(cps...),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TeamGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Team{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect
+// method is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return nil, errors.New(errNotTeam)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		organizations: organizations.NewClient(cfg),
+		teams:         teams.NewClient(cfg),
+		users:         users.NewClient(cfg),
+	}, nil
+}
+
+// OrganizationsClient is satisfied by organizations.Client. It exists so
+// that tests can supply a fake implementation.
+type OrganizationsClient interface {
+	GetByName(ctx context.Context, name string) (*organizations.GetResponse, error)
+}
+
+// TeamClient is satisfied by teams.Client. It exists so that tests can
+// supply a fake implementation.
+type TeamClient interface {
+	Get(ctx context.Context, teamID string) (*teams.GetResponse, error)
+	Create(ctx context.Context, p *teams.CreateParameters) (*teams.CreateResponse, error)
+	Update(ctx context.Context, teamID string, p *teams.UpdateParameters) error
+	Delete(ctx context.Context, teamID string) error
+	ListMembers(ctx context.Context, p *teams.ListMembersParameters) (*teams.ListMembersResponse, error)
+	AddMember(ctx context.Context, p *teams.MemberParameters) error
+	RemoveMember(ctx context.Context, p *teams.MemberParameters) error
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired
+// state.
+type external struct {
+	organizations OrganizationsClient
+	teams         TeamClient
+	users         resolve.UsersClient
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTeam)
+	}
+
+	teamID := meta.GetExternalName(cr)
+	if teamID == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	var team *teams.GetResponse
+	getErr := metrics.ObserveCall("Team", cr, "Get", func() error {
+		var err error
+		team, err = c.teams.Get(ctx, teamID)
+		return err
+	})
+	if uperrors.IsNotFound(getErr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if getErr != nil {
+		return managed.ExternalObservation{}, errors.Wrap(getErr, errGetTeam)
+	}
+
+	var members *teams.ListMembersResponse
+	err := metrics.ObserveCall("Team", cr, "ListMembers", func() error {
+		var listErr error
+		members, listErr = c.teams.ListMembers(ctx, &teams.ListMembersParameters{
+			OrganizationName: cr.Spec.ForProvider.OrganizationName,
+			TeamID:           teamID,
+		})
+		return listErr
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListMembers)
+	}
+
+	current := make([]string, 0, len(members.Members))
+	for _, m := range members.Members {
+		current = append(current, m.UserID)
+	}
+	cr.Status.AtProvider.MemberIDs = current
+	cr.Status.AtProvider.ID = team.ID
+	cr.Status.AtProvider.ManagementPolicies = cr.GetManagementPolicies()
+
+	desired, err := resolve.MemberIDs(ctx, c.users, cr.Spec.ForProvider.MemberRefs)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errResolveMembers)
+	}
+
+	cr.Status.SetConditions(v1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: team.Name == cr.Spec.ForProvider.Name && sameMembers(current, desired),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTeam)
+	}
+
+	var org *organizations.GetResponse
+	err := metrics.ObserveCall("Team", cr, "GetOrganization", func() error {
+		var getErr error
+		org, getErr = c.organizations.GetByName(ctx, cr.Spec.ForProvider.OrganizationName)
+		return getErr
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetOrg)
+	}
+
+	var rsp *teams.CreateResponse
+	err = metrics.ObserveCall("Team", cr, "Create", func() error {
+		var createErr error
+		rsp, createErr = c.teams.Create(ctx, &teams.CreateParameters{
+			Name:           cr.Spec.ForProvider.Name,
+			OrganizationID: org.ID,
+		})
+		return createErr
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTeam)
+	}
+	meta.SetExternalName(cr, rsp.ID)
+	cr.Status.AtProvider.ID = rsp.ID
+
+	if err := c.reconcileMembers(ctx, cr, rsp.ID, nil); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			"teamId": []byte(rsp.ID),
+		},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTeam)
+	}
+
+	teamID := meta.GetExternalName(cr)
+
+	var team *teams.GetResponse
+	err := metrics.ObserveCall("Team", cr, "Get", func() error {
+		var getErr error
+		team, getErr = c.teams.Get(ctx, teamID)
+		return getErr
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTeam)
+	}
+
+	if team.Name != cr.Spec.ForProvider.Name {
+		err := metrics.ObserveCall("Team", cr, "Update", func() error {
+			return c.teams.Update(ctx, teamID, &teams.UpdateParameters{Name: cr.Spec.ForProvider.Name})
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTeam)
+		}
+	}
+
+	if err := c.reconcileMembers(ctx, cr, teamID, cr.Status.AtProvider.MemberIDs); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Team)
+	if !ok {
+		return errors.New(errNotTeam)
+	}
+
+	err := metrics.ObserveCall("Team", cr, "Delete", func() error {
+		return c.teams.Delete(ctx, meta.GetExternalName(cr))
+	})
+	return errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), errDeleteTeam)
+}
+
+// reconcileMembers computes the set-difference between the Team's desired
+// and current membership and calls add/remove on the Upbound API to close
+// the gap.
+func (c *external) reconcileMembers(ctx context.Context, cr *v1alpha1.Team, teamID string, current []string) error {
+	desired, err := resolve.MemberIDs(ctx, c.users, cr.Spec.ForProvider.MemberRefs)
+	if err != nil {
+		return errors.Wrap(err, errResolveMembers)
+	}
+
+	currentSet := make(map[string]bool, len(current))
+	for _, id := range current {
+		currentSet[id] = true
+	}
+	desiredSet := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		desiredSet[id] = true
+	}
+
+	for id := range desiredSet {
+		if currentSet[id] {
+			continue
+		}
+		err := metrics.ObserveCall("Team", cr, "AddMember", func() error {
+			return c.teams.AddMember(ctx, &teams.MemberParameters{
+				OrganizationName: cr.Spec.ForProvider.OrganizationName,
+				TeamID:           teamID,
+				UserID:           id,
+			})
+		})
+		if err != nil {
+			return errors.Wrap(err, errReconcileMember)
+		}
+	}
+
+	for id := range currentSet {
+		if desiredSet[id] {
+			continue
+		}
+		err := metrics.ObserveCall("Team", cr, "RemoveMember", func() error {
+			return c.teams.RemoveMember(ctx, &teams.MemberParameters{
+				OrganizationName: cr.Spec.ForProvider.OrganizationName,
+				TeamID:           teamID,
+				UserID:           id,
+			})
+		})
+		if err != nil {
+			return errors.Wrap(err, errReconcileMember)
+		}
+	}
+
+	return nil
+}
+
+func sameMembers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+	for _, id := range b {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,360 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package team
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/upbound/provider-upbound/apis/team/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/organizations"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+	"github.com/upbound/provider-upbound/internal/client/users"
+	"github.com/upbound/provider-upbound/internal/resolve"
+)
+
+var errBoom = errors.New("boom")
+
+type fakeOrganizationsClient struct {
+	MockGetByName func(ctx context.Context, name string) (*organizations.GetResponse, error)
+}
+
+func (f *fakeOrganizationsClient) GetByName(ctx context.Context, name string) (*organizations.GetResponse, error) {
+	return f.MockGetByName(ctx, name)
+}
+
+type fakeTeamClient struct {
+	MockGet          func(ctx context.Context, teamID string) (*teams.GetResponse, error)
+	MockCreate       func(ctx context.Context, p *teams.CreateParameters) (*teams.CreateResponse, error)
+	MockUpdate       func(ctx context.Context, teamID string, p *teams.UpdateParameters) error
+	MockDelete       func(ctx context.Context, teamID string) error
+	MockListMembers  func(ctx context.Context, p *teams.ListMembersParameters) (*teams.ListMembersResponse, error)
+	MockAddMember    func(ctx context.Context, p *teams.MemberParameters) error
+	MockRemoveMember func(ctx context.Context, p *teams.MemberParameters) error
+}
+
+func (f *fakeTeamClient) Get(ctx context.Context, teamID string) (*teams.GetResponse, error) {
+	return f.MockGet(ctx, teamID)
+}
+
+func (f *fakeTeamClient) Create(ctx context.Context, p *teams.CreateParameters) (*teams.CreateResponse, error) {
+	return f.MockCreate(ctx, p)
+}
+
+func (f *fakeTeamClient) Update(ctx context.Context, teamID string, p *teams.UpdateParameters) error {
+	return f.MockUpdate(ctx, teamID, p)
+}
+
+func (f *fakeTeamClient) Delete(ctx context.Context, teamID string) error {
+	return f.MockDelete(ctx, teamID)
+}
+
+func (f *fakeTeamClient) ListMembers(ctx context.Context, p *teams.ListMembersParameters) (*teams.ListMembersResponse, error) {
+	return f.MockListMembers(ctx, p)
+}
+
+func (f *fakeTeamClient) AddMember(ctx context.Context, p *teams.MemberParameters) error {
+	return f.MockAddMember(ctx, p)
+}
+
+func (f *fakeTeamClient) RemoveMember(ctx context.Context, p *teams.MemberParameters) error {
+	return f.MockRemoveMember(ctx, p)
+}
+
+type fakeUsersClient struct {
+	MockGetByEmail func(ctx context.Context, email string) (*users.GetResponse, error)
+}
+
+func (f *fakeUsersClient) GetByEmail(ctx context.Context, email string) (*users.GetResponse, error) {
+	return f.MockGetByEmail(ctx, email)
+}
+
+func team(rev string, memberRefs ...v1alpha1.MemberRef) *v1alpha1.Team {
+	cr := &v1alpha1.Team{}
+	cr.Spec.ForProvider.OrganizationName = "my-org"
+	cr.Spec.ForProvider.Name = rev
+	cr.Spec.ForProvider.MemberRefs = memberRefs
+	meta.SetExternalName(cr, "team-1")
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		organizations OrganizationsClient
+		teams         TeamClient
+		users         resolve.UsersClient
+		mg            resource.Managed
+	}
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				teams: &fakeTeamClient{
+					MockGet: func(_ context.Context, _ string) (*teams.GetResponse, error) {
+						return &teams.GetResponse{ID: "team-1", Name: "my-team"}, nil
+					},
+					MockListMembers: func(_ context.Context, _ *teams.ListMembersParameters) (*teams.ListMembersResponse, error) {
+						return &teams.ListMembersResponse{Members: []teams.Member{{UserID: "u-1"}}}, nil
+					},
+				},
+				users: &fakeUsersClient{},
+				mg:    team("my-team", v1alpha1.MemberRef{UserID: pointer.String("u-1")}),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NameDrift": {
+			args: args{
+				teams: &fakeTeamClient{
+					MockGet: func(_ context.Context, _ string) (*teams.GetResponse, error) {
+						return &teams.GetResponse{ID: "team-1", Name: "old-name"}, nil
+					},
+					MockListMembers: func(_ context.Context, _ *teams.ListMembersParameters) (*teams.ListMembersResponse, error) {
+						return &teams.ListMembersResponse{Members: []teams.Member{{UserID: "u-1"}}}, nil
+					},
+				},
+				users: &fakeUsersClient{},
+				mg:    team("new-name", v1alpha1.MemberRef{UserID: pointer.String("u-1")}),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"MembersDiffer": {
+			args: args{
+				teams: &fakeTeamClient{
+					MockGet: func(_ context.Context, _ string) (*teams.GetResponse, error) {
+						return &teams.GetResponse{ID: "team-1", Name: "my-team"}, nil
+					},
+					MockListMembers: func(_ context.Context, _ *teams.ListMembersParameters) (*teams.ListMembersResponse, error) {
+						return &teams.ListMembersResponse{Members: []teams.Member{{UserID: "u-1"}}}, nil
+					},
+				},
+				users: &fakeUsersClient{},
+				mg:    team("my-team", v1alpha1.MemberRef{UserID: pointer.String("u-2")}),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFoundOnRemote": {
+			args: args{
+				teams: &fakeTeamClient{
+					MockGet: func(_ context.Context, _ string) (*teams.GetResponse, error) {
+						return nil, &uperrors.Error{Status: 404}
+					},
+				},
+				users: &fakeUsersClient{},
+				mg:    team("my-team"),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				users: &fakeUsersClient{},
+				mg:    &v1alpha1.Team{},
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{organizations: tc.args.organizations, teams: tc.args.teams, users: tc.args.users}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s", name, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got, cmpopts.IgnoreFields(managed.ExternalObservation{}, "ConnectionDetails", "Diff")); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", name, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		organizations OrganizationsClient
+		teams         TeamClient
+		users         resolve.UsersClient
+		mg            resource.Managed
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"OrganizationNotFound": {
+			args: args{
+				organizations: &fakeOrganizationsClient{
+					MockGetByName: func(_ context.Context, _ string) (*organizations.GetResponse, error) {
+						return nil, errBoom
+					},
+				},
+				users: &fakeUsersClient{},
+				mg:    team("my-team"),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetOrg),
+			},
+		},
+		"Success": {
+			args: args{
+				organizations: &fakeOrganizationsClient{
+					MockGetByName: func(_ context.Context, _ string) (*organizations.GetResponse, error) {
+						return &organizations.GetResponse{ID: 7, Name: "my-org"}, nil
+					},
+				},
+				teams: &fakeTeamClient{
+					MockCreate: func(_ context.Context, p *teams.CreateParameters) (*teams.CreateResponse, error) {
+						if p.OrganizationID != 7 {
+							t.Fatalf("unexpected organization id passed to Create: %d", p.OrganizationID)
+						}
+						return &teams.CreateResponse{ID: "team-1"}, nil
+					},
+					MockAddMember: func(_ context.Context, _ *teams.MemberParameters) error {
+						return nil
+					},
+				},
+				users: &fakeUsersClient{},
+				mg:    team("my-team"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{organizations: tc.args.organizations, teams: tc.args.teams, users: tc.args.users}
+			_, err := e.Create(context.Background(), tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s", name, diff)
+			}
+		})
+	}
+}
+
+func TestReconcileMembers(t *testing.T) {
+	type args struct {
+		users   resolve.UsersClient
+		teams   TeamClient
+		current []string
+		refs    []v1alpha1.MemberRef
+	}
+	type want struct {
+		added   []string
+		removed []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"AddAndRemove": {
+			args: args{
+				users:   &fakeUsersClient{},
+				current: []string{"u-1", "u-2"},
+				refs: []v1alpha1.MemberRef{
+					{UserID: pointer.String("u-2")},
+					{UserID: pointer.String("u-3")},
+				},
+			},
+			want: want{
+				added:   []string{"u-3"},
+				removed: []string{"u-1"},
+			},
+		},
+		"ResolveError": {
+			args: args{
+				users:   &fakeUsersClient{},
+				current: nil,
+				refs:    []v1alpha1.MemberRef{{}},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("member ref must set either userId or email"), errResolveMembers),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var added, removed []string
+
+			te := &fakeTeamClient{
+				MockAddMember: func(_ context.Context, p *teams.MemberParameters) error {
+					mu.Lock()
+					defer mu.Unlock()
+					added = append(added, p.UserID)
+					return nil
+				},
+				MockRemoveMember: func(_ context.Context, p *teams.MemberParameters) error {
+					mu.Lock()
+					defer mu.Unlock()
+					removed = append(removed, p.UserID)
+					return nil
+				},
+			}
+
+			cr := team("my-team", tc.args.refs...)
+			e := external{teams: te, users: tc.args.users}
+			err := e.reconcileMembers(context.Background(), cr, "team-1", tc.args.current)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.reconcileMembers(...): -want error, +got error:\n%s", name, diff)
+			}
+
+			sort.Strings(added)
+			sort.Strings(removed)
+			if diff := cmp.Diff(tc.want.added, added); diff != "" {
+				t.Errorf("\n%s\nadded members: -want, +got:\n%s", name, diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("\n%s\nremoved members: -want, +got:\n%s", name, diff)
+			}
+		})
+	}
+}
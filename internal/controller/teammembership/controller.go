@@ -0,0 +1,311 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package teammembership manages the lifecycle of TeamMembership managed
+// resources.
+package teammembership
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/upbound/provider-upbound/apis/team/v1alpha1"
+	apisv1alpha1 "github.com/upbound/provider-upbound/apis/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/organizations"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+	"github.com/upbound/provider-upbound/internal/client/users"
+	"github.com/upbound/provider-upbound/internal/features"
+	"github.com/upbound/provider-upbound/internal/metrics"
+	"github.com/upbound/provider-upbound/internal/resolve"
+)
+
+const (
+	errNotMembership = "managed resource is not a TeamMembership custom resource"
+	errTrackPCUsage  = "cannot track ProviderConfig usage"
+	errNewClient     = "cannot create new client"
+
+	errGetOrg        = "cannot resolve organizationName to an organization"
+	errGetTeamByName = "cannot resolve teamName to a team"
+	errResolveMember = "cannot resolve memberRef to a user id"
+	errListMembers   = "cannot list team members"
+	errAddMember     = "cannot add team member"
+	errRemoveMember  = "cannot remove team member"
+)
+
+// Setup adds a controller that reconciles TeamMembership managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.TeamMembershipGroupKind)
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+		}),
+		managed.WithConnectionPublishers(cps...),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableBetaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.TeamMembershipGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.TeamMembership{}).
+		Complete(r)
+}
+
+// A connector is expected to produce an ExternalClient when its Connect
+// method is called.
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TeamMembership)
+	if !ok {
+		return nil, errors.New(errNotMembership)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		organizations: organizations.NewClient(cfg),
+		teams:         teams.NewClient(cfg),
+		users:         users.NewClient(cfg),
+	}, nil
+}
+
+// OrganizationsClient is satisfied by organizations.Client. It exists so
+// that tests can supply a fake implementation.
+type OrganizationsClient interface {
+	GetByName(ctx context.Context, name string) (*organizations.GetResponse, error)
+}
+
+// TeamsClient is satisfied by teams.Client. It exists so that tests can
+// supply a fake implementation.
+type TeamsClient interface {
+	GetByName(ctx context.Context, p *teams.GetByNameParameters) (*teams.GetResponse, error)
+	ListMembers(ctx context.Context, p *teams.ListMembersParameters) (*teams.ListMembersResponse, error)
+	AddMember(ctx context.Context, p *teams.MemberParameters) error
+	RemoveMember(ctx context.Context, p *teams.MemberParameters) error
+}
+
+// An ExternalClient observes, then either creates or deletes an external
+// resource to ensure it reflects the managed resource's desired state.
+// TeamMembership has no update path. Observe looks up the member the spec
+// currently names, so after a change to the team or member it reports the
+// membership as absent and Create adds the new member; the member added
+// before the change is not removed.
+type external struct {
+	organizations OrganizationsClient
+	teams         TeamsClient
+	users         resolve.UsersClient
+}
+
+// resolveTeamID resolves a TeamMembership's organizationName and teamName to
+// the team ID the Upbound API expects.
+func (c *external) resolveTeamID(ctx context.Context, cr *v1alpha1.TeamMembership) (string, error) {
+	var org *organizations.GetResponse
+	err := metrics.ObserveCall("TeamMembership", cr, "GetOrganization", func() error {
+		var getErr error
+		org, getErr = c.organizations.GetByName(ctx, cr.Spec.ForProvider.OrganizationName)
+		return getErr
+	})
+	if err != nil {
+		return "", errors.Wrap(err, errGetOrg)
+	}
+
+	var team *teams.GetResponse
+	err = metrics.ObserveCall("TeamMembership", cr, "GetTeam", func() error {
+		var getErr error
+		team, getErr = c.teams.GetByName(ctx, &teams.GetByNameParameters{
+			OrganizationID: org.ID,
+			Name:           cr.Spec.ForProvider.TeamName,
+		})
+		return getErr
+	})
+	if err != nil {
+		return "", errors.Wrap(err, errGetTeamByName)
+	}
+
+	return team.ID, nil
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TeamMembership)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMembership)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	teamID, err := c.resolveTeamID(ctx, cr)
+	if uperrors.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	userID, err := resolve.MemberID(ctx, c.users, cr.Spec.ForProvider.MemberRef)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errResolveMember)
+	}
+
+	var members *teams.ListMembersResponse
+	err = metrics.ObserveCall("TeamMembership", cr, "ListMembers", func() error {
+		var listErr error
+		members, listErr = c.teams.ListMembers(ctx, &teams.ListMembersParameters{
+			OrganizationName: cr.Spec.ForProvider.OrganizationName,
+			TeamID:           teamID,
+		})
+		return listErr
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListMembers)
+	}
+
+	for _, m := range members.Members {
+		if m.UserID != userID {
+			continue
+		}
+		cr.Status.AtProvider.UserID = userID
+		cr.Status.AtProvider.ManagementPolicies = cr.GetManagementPolicies()
+		cr.Status.SetConditions(v1.Available())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	return managed.ExternalObservation{ResourceExists: false}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TeamMembership)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMembership)
+	}
+
+	teamID, err := c.resolveTeamID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	userID, err := resolve.MemberID(ctx, c.users, cr.Spec.ForProvider.MemberRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errResolveMember)
+	}
+
+	err = metrics.ObserveCall("TeamMembership", cr, "AddMember", func() error {
+		return c.teams.AddMember(ctx, &teams.MemberParameters{
+			OrganizationName: cr.Spec.ForProvider.OrganizationName,
+			TeamID:           teamID,
+			UserID:           userID,
+		})
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAddMember)
+	}
+
+	meta.SetExternalName(cr, teamID+"/"+userID)
+	cr.Status.AtProvider.UserID = userID
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.TeamMembership); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMembership)
+	}
+	// TeamMembership has nothing to update in place: organizationName,
+	// teamName, and memberRef together identify the external resource. A
+	// change to any of them leads Observe to report a new, absent membership
+	// that Create then adds; the previous membership is left in place.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TeamMembership)
+	if !ok {
+		return errors.New(errNotMembership)
+	}
+
+	teamID, err := c.resolveTeamID(ctx, cr)
+	if uperrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	userID, err := resolve.MemberID(ctx, c.users, cr.Spec.ForProvider.MemberRef)
+	if err != nil {
+		return errors.Wrap(err, errResolveMember)
+	}
+
+	err = metrics.ObserveCall("TeamMembership", cr, "RemoveMember", func() error {
+		return c.teams.RemoveMember(ctx, &teams.MemberParameters{
+			OrganizationName: cr.Spec.ForProvider.OrganizationName,
+			TeamID:           teamID,
+			UserID:           userID,
+		})
+	})
+	return errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), errRemoveMember)
+}
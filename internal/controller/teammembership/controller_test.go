@@ -0,0 +1,235 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teammembership
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/upbound/provider-upbound/apis/team/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/organizations"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+	"github.com/upbound/provider-upbound/internal/client/users"
+	"github.com/upbound/provider-upbound/internal/resolve"
+)
+
+var errBoom = errors.New("boom")
+
+type fakeOrganizationsClient struct {
+	MockGetByName func(ctx context.Context, name string) (*organizations.GetResponse, error)
+}
+
+func (f *fakeOrganizationsClient) GetByName(ctx context.Context, name string) (*organizations.GetResponse, error) {
+	return f.MockGetByName(ctx, name)
+}
+
+type fakeTeamsClient struct {
+	MockGetByName    func(ctx context.Context, p *teams.GetByNameParameters) (*teams.GetResponse, error)
+	MockListMembers  func(ctx context.Context, p *teams.ListMembersParameters) (*teams.ListMembersResponse, error)
+	MockAddMember    func(ctx context.Context, p *teams.MemberParameters) error
+	MockRemoveMember func(ctx context.Context, p *teams.MemberParameters) error
+}
+
+func (f *fakeTeamsClient) GetByName(ctx context.Context, p *teams.GetByNameParameters) (*teams.GetResponse, error) {
+	return f.MockGetByName(ctx, p)
+}
+
+func (f *fakeTeamsClient) ListMembers(ctx context.Context, p *teams.ListMembersParameters) (*teams.ListMembersResponse, error) {
+	return f.MockListMembers(ctx, p)
+}
+
+func (f *fakeTeamsClient) AddMember(ctx context.Context, p *teams.MemberParameters) error {
+	return f.MockAddMember(ctx, p)
+}
+
+func (f *fakeTeamsClient) RemoveMember(ctx context.Context, p *teams.MemberParameters) error {
+	return f.MockRemoveMember(ctx, p)
+}
+
+type fakeUsersClient struct {
+	MockGetByEmail func(ctx context.Context, email string) (*users.GetResponse, error)
+}
+
+func (f *fakeUsersClient) GetByEmail(ctx context.Context, email string) (*users.GetResponse, error) {
+	return f.MockGetByEmail(ctx, email)
+}
+
+func membership(withExternalName bool) *v1alpha1.TeamMembership {
+	cr := &v1alpha1.TeamMembership{}
+	cr.Spec.ForProvider.OrganizationName = "my-org"
+	cr.Spec.ForProvider.TeamName = "my-team"
+	cr.Spec.ForProvider.MemberRef = v1alpha1.MemberRef{UserID: pointer.String("u-1")}
+	if withExternalName {
+		meta.SetExternalName(cr, "team-1/u-1")
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		organizations OrganizationsClient
+		teams         TeamsClient
+		users         resolve.UsersClient
+		mg            resource.Managed
+	}
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				organizations: &fakeOrganizationsClient{
+					MockGetByName: func(_ context.Context, _ string) (*organizations.GetResponse, error) {
+						return &organizations.GetResponse{ID: 7, Name: "my-org"}, nil
+					},
+				},
+				teams: &fakeTeamsClient{
+					MockGetByName: func(_ context.Context, _ *teams.GetByNameParameters) (*teams.GetResponse, error) {
+						return &teams.GetResponse{ID: "team-1", Name: "my-team"}, nil
+					},
+					MockListMembers: func(_ context.Context, _ *teams.ListMembersParameters) (*teams.ListMembersResponse, error) {
+						return &teams.ListMembersResponse{Members: []teams.Member{{UserID: "u-1"}}}, nil
+					},
+				},
+				users: &fakeUsersClient{},
+				mg:    membership(true),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"MemberMissing": {
+			args: args{
+				organizations: &fakeOrganizationsClient{
+					MockGetByName: func(_ context.Context, _ string) (*organizations.GetResponse, error) {
+						return &organizations.GetResponse{ID: 7, Name: "my-org"}, nil
+					},
+				},
+				teams: &fakeTeamsClient{
+					MockGetByName: func(_ context.Context, _ *teams.GetByNameParameters) (*teams.GetResponse, error) {
+						return &teams.GetResponse{ID: "team-1", Name: "my-team"}, nil
+					},
+					MockListMembers: func(_ context.Context, _ *teams.ListMembersParameters) (*teams.ListMembersResponse, error) {
+						return &teams.ListMembersResponse{}, nil
+					},
+				},
+				users: &fakeUsersClient{},
+				mg:    membership(true),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"TeamNotFound": {
+			args: args{
+				organizations: &fakeOrganizationsClient{
+					MockGetByName: func(_ context.Context, _ string) (*organizations.GetResponse, error) {
+						return &organizations.GetResponse{ID: 7, Name: "my-org"}, nil
+					},
+				},
+				teams: &fakeTeamsClient{
+					MockGetByName: func(_ context.Context, _ *teams.GetByNameParameters) (*teams.GetResponse, error) {
+						return nil, &uperrors.Error{Status: 404}
+					},
+				},
+				users: &fakeUsersClient{},
+				mg:    membership(true),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				mg: membership(false),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{organizations: tc.args.organizations, teams: tc.args.teams, users: tc.args.users}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s", name, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got, cmpopts.IgnoreFields(managed.ExternalObservation{}, "ConnectionDetails", "Diff")); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", name, diff)
+			}
+		})
+	}
+}
+
+func TestResolveTeamID(t *testing.T) {
+	type args struct {
+		organizations OrganizationsClient
+		teams         TeamsClient
+		mg            *v1alpha1.TeamMembership
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"OrganizationNotFound": {
+			args: args{
+				organizations: &fakeOrganizationsClient{
+					MockGetByName: func(_ context.Context, _ string) (*organizations.GetResponse, error) {
+						return nil, errBoom
+					},
+				},
+				mg: membership(false),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetOrg),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{organizations: tc.args.organizations, teams: tc.args.teams}
+			_, err := e.resolveTeamID(context.Background(), tc.args.mg)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.resolveTeamID(...): -want error, +got error:\n%s", name, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package features defines feature flags shared by this provider's
+// controllers.
+package features
+
+const (
+	// EnableBetaManagementPolicies enables the use of the management
+	// policies functionality, which facilitates advanced and granular
+	// control over managed resources.
+	EnableBetaManagementPolicies = "EnableBetaManagementPolicies"
+
+	// EnableAlphaExternalSecretStores enables the use of External Secret
+	// Stores, which allows the provider to publish connection details to
+	// stores other than a Kubernetes Secret, e.g. Vault.
+	EnableAlphaExternalSecretStores = "EnableAlphaExternalSecretStores"
+)
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics provides a thin wrapper around calls to the Upbound API so
+// that operators can alert on Upbound API degradation independent of the
+// usual controller-runtime reconcile metrics.
+package metrics
+
+import (
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+)
+
+var upboundRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name: "provider_upbound_api_request_duration_seconds",
+	Help: "Latency of calls made to the Upbound API, by resource kind, operation, outcome, and ProviderConfig.",
+}, []string{"resource", "operation", "outcome", "providerconfigref"})
+
+func init() {
+	metrics.Registry.MustRegister(upboundRequestDuration)
+}
+
+// ObserveCall records the latency and outcome of a single call to the
+// Upbound API made on behalf of mg. resourceKind identifies the managed
+// resource kind the call was made for - it must be passed explicitly rather
+// than read off mg, because mg's TypeMeta (and so GetObjectKind().
+// GroupVersionKind()) is typically empty for objects the controller-runtime
+// typed client has already fetched. The outcome is classified as
+// "not_found" or "error" using the same uperrors helper the controllers
+// already use to decide whether a resource has been deleted out of band.
+func ObserveCall(resourceKind string, mg resource.Managed, operation string, call func() error) error {
+	start := time.Now()
+	err := call()
+
+	var providerConfigRef string
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		providerConfigRef = ref.Name
+	}
+
+	upboundRequestDuration.
+		WithLabelValues(resourceKind, operation, outcome(err), providerConfigRef).
+		Observe(time.Since(start).Seconds())
+	return err
+}
+
+func outcome(err error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case uperrors.IsNotFound(err):
+		return "not_found"
+	default:
+		return "error"
+	}
+}
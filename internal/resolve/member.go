@@ -0,0 +1,67 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resolve resolves the team.v1alpha1.MemberRef selector - by
+// UserID directly, or by Email via the Upbound users API - into a concrete
+// Upbound user ID. It's shared by the Team and TeamMembership controllers
+// so that both honor the same selector semantics.
+package resolve
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/upbound/provider-upbound/apis/team/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/users"
+)
+
+const errMemberRefEmpty = "member ref must set either userId or email"
+
+// UsersClient is satisfied by users.Client. It exists so that tests can
+// supply a fake implementation.
+type UsersClient interface {
+	GetByEmail(ctx context.Context, email string) (*users.GetResponse, error)
+}
+
+// MemberID resolves a single MemberRef to an Upbound user ID, looking it up
+// by email via usersClient when UserID isn't set directly.
+func MemberID(ctx context.Context, usersClient UsersClient, ref v1alpha1.MemberRef) (string, error) {
+	if ref.UserID != nil && *ref.UserID != "" {
+		return *ref.UserID, nil
+	}
+	if ref.Email != nil && *ref.Email != "" {
+		u, err := usersClient.GetByEmail(ctx, *ref.Email)
+		if err != nil {
+			return "", errors.Wrapf(err, "cannot resolve email %q to a user", *ref.Email)
+		}
+		return u.UserID, nil
+	}
+	return "", errors.New(errMemberRefEmpty)
+}
+
+// MemberIDs resolves every MemberRef in refs to an Upbound user ID.
+func MemberIDs(ctx context.Context, usersClient UsersClient, refs []v1alpha1.MemberRef) ([]string, error) {
+	ids := make([]string, 0, len(refs))
+	for _, r := range refs {
+		id, err := MemberID(ctx, usersClient, r)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
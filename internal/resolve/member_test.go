@@ -0,0 +1,156 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolve
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/upbound/provider-upbound/apis/team/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/users"
+)
+
+var errBoom = errors.New("boom")
+
+type fakeUsersClient struct {
+	MockGetByEmail func(ctx context.Context, email string) (*users.GetResponse, error)
+}
+
+func (f *fakeUsersClient) GetByEmail(ctx context.Context, email string) (*users.GetResponse, error) {
+	return f.MockGetByEmail(ctx, email)
+}
+
+func TestMemberID(t *testing.T) {
+	type args struct {
+		client UsersClient
+		ref    v1alpha1.MemberRef
+	}
+	type want struct {
+		id  string
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UserIDSet": {
+			args: args{
+				client: &fakeUsersClient{},
+				ref:    v1alpha1.MemberRef{UserID: pointer.String("u-1")},
+			},
+			want: want{id: "u-1"},
+		},
+		"EmailResolved": {
+			args: args{
+				client: &fakeUsersClient{
+					MockGetByEmail: func(_ context.Context, email string) (*users.GetResponse, error) {
+						if email != "a@example.com" {
+							t.Fatalf("unexpected email passed to GetByEmail: %s", email)
+						}
+						return &users.GetResponse{UserID: "u-2", Email: email}, nil
+					},
+				},
+				ref: v1alpha1.MemberRef{Email: pointer.String("a@example.com")},
+			},
+			want: want{id: "u-2"},
+		},
+		"EmailLookupError": {
+			args: args{
+				client: &fakeUsersClient{
+					MockGetByEmail: func(_ context.Context, _ string) (*users.GetResponse, error) {
+						return nil, errBoom
+					},
+				},
+				ref: v1alpha1.MemberRef{Email: pointer.String("a@example.com")},
+			},
+			want: want{err: errors.Wrapf(errBoom, "cannot resolve email %q to a user", "a@example.com")},
+		},
+		"Empty": {
+			args: args{
+				client: &fakeUsersClient{},
+				ref:    v1alpha1.MemberRef{},
+			},
+			want: want{err: errors.New(errMemberRefEmpty)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := MemberID(context.Background(), tc.args.client, tc.args.ref)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nMemberID(...): -want error, +got error:\n%s", name, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("\n%s\nMemberID(...): -want, +got:\n%s", name, diff)
+			}
+		})
+	}
+}
+
+func TestMemberIDs(t *testing.T) {
+	type args struct {
+		client UsersClient
+		refs   []v1alpha1.MemberRef
+	}
+	type want struct {
+		ids []string
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"AllUserIDs": {
+			args: args{
+				client: &fakeUsersClient{},
+				refs: []v1alpha1.MemberRef{
+					{UserID: pointer.String("u-1")},
+					{UserID: pointer.String("u-2")},
+				},
+			},
+			want: want{ids: []string{"u-1", "u-2"}},
+		},
+		"PropagatesMemberError": {
+			args: args{
+				client: &fakeUsersClient{},
+				refs:   []v1alpha1.MemberRef{{}},
+			},
+			want: want{err: errors.New(errMemberRefEmpty)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ids, err := MemberIDs(context.Background(), tc.args.client, tc.args.refs)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nMemberIDs(...): -want error, +got error:\n%s", name, diff)
+			}
+			if diff := cmp.Diff(tc.want.ids, ids); diff != "" {
+				t.Errorf("\n%s\nMemberIDs(...): -want, +got:\n%s", name, diff)
+			}
+		})
+	}
+}